@@ -0,0 +1,133 @@
+package orderedmap
+
+import "iter"
+
+// Iterator walks an OrderedMap's entries in insertion order, forwards or
+// backwards. Create one with Iter or IterAt; the zero value is not usable.
+//
+// Internally it holds a cursor into the map's linked list, so Next and
+// Prev are O(1) regardless of map size.
+//
+// An Iterator snapshots the OrderedMap it was created from: it holds its
+// own copy of the map's header, not a pointer into the variable Iter or
+// IterAt was called on. Reassigning that variable afterwards (for example
+// om = om.Delete(key)) does not affect an Iterator already obtained from
+// it, since that reassignment gives om a clone rather than mutating the
+// entries the Iterator is still walking.
+type Iterator[K comparable, V any] struct {
+	om      OrderedMap[K, V]
+	current *entry[K, V]
+	pos     int
+}
+
+// Iter returns an Iterator positioned before the first entry.
+// Call Next to advance to the first entry.
+func (om OrderedMap[K, V]) Iter() *Iterator[K, V] {
+	return &Iterator[K, V]{om: om, pos: -1}
+}
+
+// IterAt returns an Iterator positioned at pos, ready to be read with Key
+// and Value without an initial call to Next. Negative positions count
+// from the end (-1 is the last element). If pos is out of range, the
+// returned Iterator behaves as if created with Iter.
+func (om OrderedMap[K, V]) IterAt(pos int) *Iterator[K, V] {
+	ix, ok := normalizePosition(pos, om.Len())
+	if !ok {
+		return om.Iter()
+	}
+
+	return &Iterator[K, V]{om: om, current: om.nodeAt(ix), pos: ix}
+}
+
+// Next advances the iterator to the next entry and reports whether there
+// is one.
+func (it *Iterator[K, V]) Next() bool {
+	switch {
+	case it.pos == it.om.Len():
+		return false
+	case it.pos == -1:
+		it.current = it.om.head
+	default:
+		it.current = it.current.next
+	}
+
+	it.pos++
+	return it.current != nil
+}
+
+// Prev moves the iterator to the previous entry and reports whether there
+// is one.
+func (it *Iterator[K, V]) Prev() bool {
+	switch {
+	case it.pos == -1:
+		return false
+	case it.pos == it.om.Len():
+		it.current = it.om.tail
+	default:
+		it.current = it.current.prev
+	}
+
+	it.pos--
+	return it.current != nil
+}
+
+// Key returns the key at the iterator's current position.
+func (it *Iterator[K, V]) Key() K {
+	if it.current == nil {
+		var k K
+		return k
+	}
+	return it.current.key
+}
+
+// Value returns the value at the iterator's current position.
+func (it *Iterator[K, V]) Value() V {
+	if it.current == nil {
+		var v V
+		return v
+	}
+	return it.current.value
+}
+
+// Index returns the iterator's current position.
+func (it *Iterator[K, V]) Index() int {
+	return it.pos
+}
+
+// All returns an iterator over the map's key-value pairs in insertion
+// order, for use with range (Go 1.23+):
+//
+//	for k, v := range om.All() {
+//		...
+//	}
+func (om OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := om.head; e != nil; e = e.next {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the map's keys in insertion order.
+func (om OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for e := om.head; e != nil; e = e.next {
+			if !yield(e.key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the map's values in insertion order.
+func (om OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := om.head; e != nil; e = e.next {
+			if !yield(e.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package orderedmap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIteratorForward(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	it := om.Iter()
+
+	i := 0
+	for it.Next() {
+		assert.Equal(t, sample[i].key, it.Key())
+		assert.Equal(t, sample[i].value, it.Value())
+		assert.Equal(t, i, it.Index())
+		i++
+	}
+	assert.Equal(t, len(sample), i)
+	assert.False(t, it.Next())
+}
+
+func TestIteratorBackward(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	it := om.IterAt(-1)
+
+	i := len(sample) - 1
+	for {
+		assert.Equal(t, sample[i].key, it.Key())
+		assert.Equal(t, sample[i].value, it.Value())
+		if !it.Prev() {
+			break
+		}
+		i--
+	}
+	assert.Equal(t, 0, i)
+	assert.False(t, it.Prev())
+}
+
+func TestIterAtOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	it := om.IterAt(100)
+
+	assert.True(t, it.Next())
+	assert.Equal(t, sample[0].key, it.Key())
+}
+
+func TestIteratorIsUnaffectedByLaterReassignment(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	it := om.Iter()
+
+	om = om.Delete(sample[1].key)
+	om, _ = om.InsertAt(0, "new", "z")
+
+	i := 0
+	for it.Next() {
+		assert.Equal(t, sample[i].key, it.Key())
+		assert.Equal(t, sample[i].value, it.Value())
+		i++
+	}
+	assert.Equal(t, len(sample), i)
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+
+	i := 0
+	for k, v := range om.All() {
+		assert.Equal(t, sample[i].key, k)
+		assert.Equal(t, sample[i].value, v)
+		i++
+	}
+	assert.Equal(t, len(sample), i)
+}
+
+func TestKeys(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+
+	i := 0
+	for k := range om.Keys() {
+		assert.Equal(t, sample[i].key, k)
+		i++
+	}
+	assert.Equal(t, len(sample), i)
+}
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+
+	i := 0
+	for v := range om.Values() {
+		assert.Equal(t, sample[i].value, v)
+		i++
+	}
+	assert.Equal(t, len(sample), i)
+}
@@ -0,0 +1,103 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// OrderedStringMap is an OrderedMap keyed by string that marshals to and
+// from a native JSON object (e.g. {"a":1,"b":2}) instead of the
+// [{"Key":"a","Value":1}, ...] array form used by OrderedMap[K, V].
+// Insertion order is preserved on both encode and decode.
+type OrderedStringMap[V any] struct {
+	OrderedMap[string, V]
+}
+
+// NewStringMap creates a new, empty OrderedStringMap.
+func NewStringMap[V any](opts ...Option[string, V]) OrderedStringMap[V] {
+	return OrderedStringMap[V]{OrderedMap: New(opts...)}
+}
+
+// MarshalJSON encodes the map as a JSON object, in insertion order.
+func (om OrderedStringMap[V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	first := true
+	var outerErr error
+	_ = om.ForEach(func(key string, val V) error {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		keyJSON, err := json.Marshal(key)
+		if err != nil {
+			outerErr = err
+			return err
+		}
+		buf.Write(keyJSON)
+		buf.WriteByte(':')
+
+		valJSON, err := json.Marshal(val)
+		if err != nil {
+			outerErr = err
+			return err
+		}
+		buf.Write(valJSON)
+
+		return nil
+	})
+	if outerErr != nil {
+		return nil, outerErr
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map by streaming its
+// tokens, so that insertion order matches the order keys appear in data.
+func (om *OrderedStringMap[V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("orderedmap: expected JSON object, got %v", tok)
+	}
+
+	newMap := New[string, V]()
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := keyTok.(string)
+		if !ok {
+			return fmt.Errorf("orderedmap: expected string key, got %v", keyTok)
+		}
+
+		var val V
+		if err := dec.Decode(&val); err != nil {
+			return err
+		}
+
+		newMap, err = newMap.Set(key, val)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	om.OrderedMap = newMap
+
+	return nil
+}
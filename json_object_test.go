@@ -0,0 +1,64 @@
+package orderedmap_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/schoren/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStringMapFromSample(t *testing.T) orderedmap.OrderedStringMap[string] {
+	t.Helper()
+
+	om := orderedmap.NewStringMap[string]()
+	for _, s := range sample {
+		var err error
+
+		om.OrderedMap, err = om.OrderedMap.Set(s.key, s.value)
+		require.NoError(t, err)
+	}
+
+	return om
+}
+
+func TestOrderedStringMapJSON(t *testing.T) {
+	t.Parallel()
+
+	om := newStringMapFromSample(t)
+
+	data, err := json.Marshal(om)
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"first item":"a","this is the second item":"b","3rd item":"c"}`, string(data))
+
+	var unmarshalled orderedmap.OrderedStringMap[string]
+	err = json.Unmarshal(data, &unmarshalled)
+	require.NoError(t, err)
+
+	i := 0
+	err = unmarshalled.ForEach(func(key string, val string) error {
+		assert.Equal(t, sample[i].key, key)
+		assert.Equal(t, sample[i].value, val)
+		i++
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestOrderedStringMapUnmarshalRejectsNonObject(t *testing.T) {
+	t.Parallel()
+
+	var om orderedmap.OrderedStringMap[string]
+	err := json.Unmarshal([]byte(`["not", "an", "object"]`), &om)
+	assert.Error(t, err)
+}
+
+func TestOrderedStringMapUnmarshalRejectsDuplicateKeys(t *testing.T) {
+	t.Parallel()
+
+	var om orderedmap.OrderedStringMap[string]
+	err := json.Unmarshal([]byte(`{"a":"1","a":"2"}`), &om)
+	assert.ErrorIs(t, err, orderedmap.ErrKeyAlreadyExists)
+}
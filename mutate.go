@@ -0,0 +1,84 @@
+package orderedmap
+
+import "errors"
+
+// ErrKeyNotFound is returned when trying to update a key that does not exist.
+var ErrKeyNotFound = errors.New("key not found")
+
+// Put inserts key if it is missing, or updates its value in place
+// (preserving its existing position) if it already exists.
+func (om OrderedMap[K, V]) Put(key K, value V) OrderedMap[K, V] {
+	if !om.Contains(key) {
+		om, _ = om.Set(key, value)
+		return om
+	}
+
+	fresh := om.clone()
+	fresh.entries[key].value = value
+
+	return fresh
+}
+
+// Replace updates the value for key if it exists, preserving its
+// position, and returns the updated map. If the key does not exist, om is
+// returned unchanged along with ErrKeyNotFound.
+func (om OrderedMap[K, V]) Replace(key K, value V) (OrderedMap[K, V], error) {
+	if !om.Contains(key) {
+		return om, ErrKeyNotFound
+	}
+
+	fresh := om.clone()
+	fresh.entries[key].value = value
+
+	return fresh, nil
+}
+
+// Merge combines other into om: entries present in om are resolved via
+// onConflict, and entries absent from om are appended in other's order.
+// The relative order of om's existing entries is preserved. The result is
+// an independent copy, so resolving conflicts never mutates om or any
+// other OrderedMap value that shares om's entries.
+func (om OrderedMap[K, V]) Merge(other OrderedMap[K, V], onConflict func(key K, existing, incoming V) V) OrderedMap[K, V] {
+	result := om.clone()
+
+	_ = other.ForEach(func(key K, incoming V) error {
+		if existing, exists := result.entries[key]; exists {
+			existing.value = onConflict(key, existing.value, incoming)
+			return nil
+		}
+
+		_ = result.setIn(key, incoming)
+
+		return nil
+	})
+
+	return result
+}
+
+// Filter returns a new OrderedMap containing only the entries for which
+// fn returns true, preserving their relative order.
+func (om OrderedMap[K, V]) Filter(fn func(key K, val V) bool) OrderedMap[K, V] {
+	result := New[K, V]()
+	_ = om.ForEach(func(key K, val V) error {
+		if fn(key, val) {
+			_ = result.setIn(key, val)
+		}
+		return nil
+	})
+
+	return result
+}
+
+// Map transforms the values of om using fn, in order, returning a new
+// OrderedMap with the same keys. It is a package-level function rather
+// than a method because Go does not allow methods to introduce type
+// parameters beyond those of their receiver.
+func Map[K comparable, V, V2 any](om OrderedMap[K, V], fn func(key K, val V) V2) OrderedMap[K, V2] {
+	result := New[K, V2]()
+	_ = om.ForEach(func(key K, val V) error {
+		_ = result.setIn(key, fn(key, val))
+		return nil
+	})
+
+	return result
+}
@@ -0,0 +1,154 @@
+package orderedmap_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/schoren/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutInsertsMissingKey(t *testing.T) {
+	t.Parallel()
+
+	om := orderedmap.New[string, string]()
+	om = om.Put("key", "value")
+
+	assert.Equal(t, "value", om.Get("key"))
+	assert.Equal(t, 1, om.Len())
+}
+
+func TestPutUpdatesExistingKeyInPlace(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	om = om.Put(sample[0].key, "updated")
+
+	assert.Equal(t, "updated", om.Get(sample[0].key))
+	assert.Equal(t, len(sample), om.Len())
+
+	key, ok := om.KeyAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, sample[0].key, key)
+}
+
+func TestReplace(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	om, err := om.Replace(sample[0].key, "updated")
+	require.NoError(t, err)
+
+	assert.Equal(t, "updated", om.Get(sample[0].key))
+}
+
+func TestReplaceReturnsErrorIfKeyNotFound(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	_, err := om.Replace("missing", "updated")
+	assert.ErrorIs(t, err, orderedmap.ErrKeyNotFound)
+}
+
+func TestPutDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := orderedmap.New[string, int]().MustSet("a", 1)
+	om2 := om1
+	om2 = om2.Put("a", 2)
+
+	assert.Equal(t, 1, om1.Get("a"))
+	assert.Equal(t, 2, om2.Get("a"))
+}
+
+func TestReplaceDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := orderedmap.New[string, int]().MustSet("a", 1)
+	om2 := om1
+	om2, err := om2.Replace("a", 99)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, om1.Get("a"))
+	assert.Equal(t, 99, om2.Get("a"))
+}
+
+func TestMergeDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	a1 := orderedmap.New[string, int]().MustSet("a", 1).MustSet("b", 2)
+	a2 := a1
+	b := orderedmap.New[string, int]().MustSet("b", 20)
+
+	merged := a2.Merge(b, func(key string, existing, incoming int) int {
+		return existing + incoming
+	})
+
+	assert.Equal(t, 2, a1.Get("b"))
+	assert.Equal(t, 22, merged.Get("b"))
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	a := orderedmap.New[string, int]().MustSet("a", 1).MustSet("b", 2)
+	b := orderedmap.New[string, int]().MustSet("b", 20).MustSet("c", 3)
+
+	merged := a.Merge(b, func(key string, existing, incoming int) int {
+		return existing + incoming
+	})
+
+	assert.Equal(t, 3, merged.Len())
+	assert.Equal(t, 1, merged.Get("a"))
+	assert.Equal(t, 22, merged.Get("b"))
+	assert.Equal(t, 3, merged.Get("c"))
+
+	key, ok := merged.KeyAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, "a", key)
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	filtered := om.Filter(func(key, val string) bool {
+		return val != sample[1].value
+	})
+
+	assert.Equal(t, len(sample)-1, filtered.Len())
+	assert.False(t, filtered.Contains(sample[1].key))
+	assert.True(t, filtered.Contains(sample[0].key))
+	assert.True(t, filtered.Contains(sample[2].key))
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	mapped := orderedmap.Map(om, func(key, val string) int {
+		return len(val)
+	})
+
+	i := 0
+	err := mapped.ForEach(func(key string, val int) error {
+		assert.Equal(t, sample[i].key, key)
+		assert.Equal(t, len(sample[i].value), val)
+		i++
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestMapToDifferentType(t *testing.T) {
+	t.Parallel()
+
+	om := orderedmap.New[string, int]().MustSet("a", 1).MustSet("b", 2)
+	mapped := orderedmap.Map(om, func(key string, val int) string {
+		return key + ":" + strconv.Itoa(val)
+	})
+
+	assert.Equal(t, "a:1", mapped.Get("a"))
+	assert.Equal(t, "b:2", mapped.Get("b"))
+}
@@ -82,22 +82,92 @@ import (
 	"fmt"
 )
 
+// entry is a node in the doubly-linked list backing an OrderedMap.
+type entry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *entry[K, V]
+}
+
 // OrderedMap is a map that maintains the order of insertion.
+//
+// Entries are held in a doubly-linked list, indexed by a map from key to
+// node.
+//
+// OrderedMap is a value type: Set, Delete, InsertAt, and the Move* methods
+// return a new OrderedMap rather than modifying the receiver, so the usual
+// pattern is to reassign the result (om, err = om.Set(...)). Copying an
+// OrderedMap (plain assignment, passing it to a function, storing it in a
+// slice) shares its entries map and nodes with the copy, so every one of
+// those mutators clones the map's entries before changing them. This keeps
+// an older binding of the same map - a snapshot kept for diffing or undo,
+// say - safe to go on using after a newer binding derived from it is
+// mutated, at the cost of making Set, Delete, InsertAt, and the Move*
+// methods O(n) rather than the O(1) a single, exclusively-owned linked
+// list would allow. Get, Contains, ForEach, and the other read-only
+// methods are unaffected.
 type OrderedMap[K comparable, V any] struct {
-	list        []V
-	keyPosition map[K]int
-	positionKey map[int]K
+	entries    map[K]*entry[K, V]
+	head, tail *entry[K, V]
+	length     int
 }
 
-// New creates a new OrderedMap.
-func New[K comparable, V any]() OrderedMap[K, V] {
-	return OrderedMap[K, V]{
-		list:        []V{},
-		keyPosition: make(map[K]int),
-		positionKey: make(map[int]K),
+// Option configures an OrderedMap created by New.
+type Option[K comparable, V any] func(*OrderedMap[K, V])
+
+// WithCapacity preallocates the underlying storage for n entries,
+// avoiding reallocations when the final size is known up front. It is
+// safe to combine with WithInitialData regardless of the order the two
+// are passed to New in: any entries already present are rehashed into
+// the newly sized map rather than discarded.
+func WithCapacity[K comparable, V any](n int) Option[K, V] {
+	return func(om *OrderedMap[K, V]) {
+		capacity := n
+		if len(om.entries) > capacity {
+			capacity = len(om.entries)
+		}
+
+		fresh := make(map[K]*entry[K, V], capacity)
+		for k, e := range om.entries {
+			fresh[k] = e
+		}
+
+		om.entries = fresh
 	}
 }
 
+// WithInitialData seeds the map with pairs, in order.
+// It panics if any key is duplicated, consistently with MustSet.
+func WithInitialData[K comparable, V any](pairs ...Pair[K, V]) Option[K, V] {
+	return func(om *OrderedMap[K, V]) {
+		for _, p := range pairs {
+			if err := om.setIn(p.Key, p.Value); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// Pair is a key-value pair used to seed an OrderedMap via WithInitialData
+// or to add several entries at once via AddPairs.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// New creates a new OrderedMap, applying the given options in order.
+func New[K comparable, V any](opts ...Option[K, V]) OrderedMap[K, V] {
+	om := OrderedMap[K, V]{
+		entries: make(map[K]*entry[K, V]),
+	}
+
+	for _, opt := range opts {
+		opt(&om)
+	}
+
+	return om
+}
+
 // MustSet is like Set, but panics if an error occurs.
 // It simplifies initialization enabling chaining.
 func (om OrderedMap[K, V]) MustSet(key K, asserts V) OrderedMap[K, V] {
@@ -127,79 +197,370 @@ func (e KeyAlreadyExistsError) Error() string {
 // ErrKeyAlreadyExists is returned when trying to add a key that already exists.
 var ErrKeyAlreadyExists = errors.New("key already exists")
 
-// Set adds a new key-value pair to the map.
-// If the key already exists, an error is returned.
-func (om OrderedMap[K, V]) Set(key K, asserts V) (OrderedMap[K, V], error) {
-	if om.keyPosition == nil {
-		om.keyPosition = make(map[K]int)
+// clone returns an independent copy of om: a fresh entries map and a
+// fresh chain of nodes. om's entries map and nodes are shared with every
+// other OrderedMap value copied from it, so every method that mutates the
+// map's structure or an entry's value clones before doing so, to avoid
+// mutating state that those other copies can still observe.
+func (om OrderedMap[K, V]) clone() OrderedMap[K, V] {
+	fresh := OrderedMap[K, V]{entries: make(map[K]*entry[K, V], om.length)}
+
+	for e := om.head; e != nil; e = e.next {
+		ne := &entry[K, V]{key: e.key, value: e.value}
+		fresh.linkBack(ne)
+		fresh.entries[e.key] = ne
 	}
-	if om.positionKey == nil {
-		om.positionKey = make(map[int]K)
+	fresh.length = om.length
+
+	return fresh
+}
+
+// setIn adds key/value to om, assuming om is exclusively owned by the
+// caller (e.g. the result of clone), so no further cloning is needed.
+func (om *OrderedMap[K, V]) setIn(key K, value V) error {
+	if _, exists := om.entries[key]; exists {
+		return KeyAlreadyExistsError{key}
 	}
 
-	if _, exists := om.keyPosition[key]; exists {
+	e := &entry[K, V]{key: key, value: value}
+	om.linkBack(e)
+	om.entries[key] = e
+	om.length++
+
+	return nil
+}
+
+// Set adds a new key-value pair to the map.
+// If the key already exists, an error is returned.
+func (om OrderedMap[K, V]) Set(key K, asserts V) (OrderedMap[K, V], error) {
+	if _, exists := om.entries[key]; exists {
 		return OrderedMap[K, V]{}, KeyAlreadyExistsError{key}
 	}
 
-	om.list = append(om.list, asserts)
-	ix := len(om.list) - 1
-	om.keyPosition[key] = ix
-	om.positionKey[ix] = key
+	fresh := om.clone()
+	_ = fresh.setIn(key, asserts)
+
+	return fresh, nil
+}
+
+// AddPairs adds multiple key-value pairs to the map, in order.
+// If any key already exists, an error is returned and the map is unchanged.
+func (om OrderedMap[K, V]) AddPairs(pairs ...Pair[K, V]) (OrderedMap[K, V], error) {
+	fresh := om.clone()
+
+	for _, p := range pairs {
+		if err := fresh.setIn(p.Key, p.Value); err != nil {
+			return OrderedMap[K, V]{}, err
+		}
+	}
 
-	return om, nil
+	return fresh, nil
 }
 
 // Delete removes a key from the map.
 // If the key does not exist, the map is returned unchanged.
 func (om OrderedMap[K, V]) Delete(key K) OrderedMap[K, V] {
-	ix, exists := om.keyPosition[key]
-	if !exists {
+	if _, exists := om.entries[key]; !exists {
 		return om
 	}
 
-	delete(om.keyPosition, key)
-	delete(om.positionKey, ix)
+	fresh := om.clone()
+	e := fresh.entries[key]
+	delete(fresh.entries, key)
+	fresh.unlink(e)
+	fresh.length--
+
+	return fresh
+}
+
+// ErrPositionOutOfRange is returned when a position argument is out of the
+// valid range for the map.
+var ErrPositionOutOfRange = errors.New("position out of range")
 
-	om.list = append(om.list[:ix], om.list[ix+1:]...)
-	for i := ix; i < len(om.list); i++ {
-		k := om.positionKey[i+1]
-		om.keyPosition[k] = i
-		om.positionKey[i] = k
+// normalizePosition resolves pos against bound, allowing negative indices
+// to count from the end (-1 is bound-1). It reports whether the resulting
+// position is within [0, bound).
+func normalizePosition(pos, bound int) (int, bool) {
+	if pos < 0 {
+		pos += bound
+	}
+	if pos < 0 || pos >= bound {
+		return 0, false
 	}
 
-	return om
+	return pos, true
+}
+
+// normalizeInsertPosition resolves pos against length for insertion,
+// allowing negative indices to count from the end (-1 inserts before the
+// current last element). It reports whether the resulting position is
+// within [0, length].
+func normalizeInsertPosition(pos, length int) (int, bool) {
+	if pos < 0 {
+		pos += length
+	}
+	if pos < 0 || pos > length {
+		return 0, false
+	}
+
+	return pos, true
+}
+
+// nodeAt walks the linked list to the entry at ix, starting from whichever
+// end is closer. It assumes 0 <= ix < om.length.
+func (om OrderedMap[K, V]) nodeAt(ix int) *entry[K, V] {
+	if ix <= om.length/2 {
+		e := om.head
+		for i := 0; i < ix; i++ {
+			e = e.next
+		}
+		return e
+	}
+
+	e := om.tail
+	for i := om.length - 1; i > ix; i-- {
+		e = e.prev
+	}
+	return e
+}
+
+// unlink removes e from the linked list without touching om.entries or
+// om.length.
+func (om *OrderedMap[K, V]) unlink(e *entry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		om.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		om.tail = e.prev
+	}
+	e.prev, e.next = nil, nil
+}
+
+// linkFront inserts e at the head of the linked list.
+func (om *OrderedMap[K, V]) linkFront(e *entry[K, V]) {
+	e.prev, e.next = nil, om.head
+	if om.head != nil {
+		om.head.prev = e
+	} else {
+		om.tail = e
+	}
+	om.head = e
+}
+
+// linkBack inserts e at the tail of the linked list.
+func (om *OrderedMap[K, V]) linkBack(e *entry[K, V]) {
+	e.prev, e.next = om.tail, nil
+	if om.tail != nil {
+		om.tail.next = e
+	} else {
+		om.head = e
+	}
+	om.tail = e
+}
+
+// linkBefore inserts e immediately before mark.
+func (om *OrderedMap[K, V]) linkBefore(e, mark *entry[K, V]) {
+	prev := mark.prev
+	e.prev, e.next = prev, mark
+	mark.prev = e
+	if prev != nil {
+		prev.next = e
+	} else {
+		om.head = e
+	}
+}
+
+// linkAfter inserts e immediately after mark.
+func (om *OrderedMap[K, V]) linkAfter(e, mark *entry[K, V]) {
+	next := mark.next
+	e.prev, e.next = mark, next
+	mark.next = e
+	if next != nil {
+		next.prev = e
+	} else {
+		om.tail = e
+	}
+}
+
+// InsertAt inserts a new key-value pair at pos, shifting the entries at
+// and after pos back by one. Negative positions count from the end (-1
+// inserts before the last element). If the key already exists, or pos is
+// out of range, an error is returned and the map is unchanged.
+//
+// InsertAt is O(n): reaching pos requires walking the linked list unless
+// pos is at the front or back, and the map is cloned first regardless of
+// pos.
+func (om OrderedMap[K, V]) InsertAt(pos int, key K, value V) (OrderedMap[K, V], error) {
+	if _, exists := om.entries[key]; exists {
+		return OrderedMap[K, V]{}, KeyAlreadyExistsError{key}
+	}
+
+	ix, ok := normalizeInsertPosition(pos, om.length)
+	if !ok {
+		return OrderedMap[K, V]{}, ErrPositionOutOfRange
+	}
+
+	fresh := om.clone()
+
+	e := &entry[K, V]{key: key, value: value}
+
+	switch {
+	case ix == fresh.length:
+		fresh.linkBack(e)
+	case ix == 0:
+		fresh.linkFront(e)
+	default:
+		fresh.linkBefore(e, fresh.nodeAt(ix))
+	}
+
+	fresh.entries[key] = e
+	fresh.length++
+
+	return fresh, nil
+}
+
+// GetAt returns the key and value at pos, and whether pos was valid.
+// Negative positions count from the end (-1 is the last element).
+// Runs in O(n) in the worst case, since reaching pos requires walking the
+// linked list from whichever end is closer.
+func (om OrderedMap[K, V]) GetAt(pos int) (K, V, bool) {
+	ix, ok := normalizePosition(pos, om.length)
+	if !ok {
+		var k K
+		var v V
+		return k, v, false
+	}
+
+	e := om.nodeAt(ix)
+	return e.key, e.value, true
+}
+
+// KeyAt returns the key at pos, and whether pos was valid.
+// Negative positions count from the end (-1 is the last element).
+// Runs in O(n) in the worst case, since reaching pos requires walking the
+// linked list from whichever end is closer.
+func (om OrderedMap[K, V]) KeyAt(pos int) (K, bool) {
+	ix, ok := normalizePosition(pos, om.length)
+	if !ok {
+		var k K
+		return k, false
+	}
+
+	return om.nodeAt(ix).key, true
+}
+
+// MoveToFront moves key to the front of the map, preserving the relative
+// order of the remaining entries. If the key does not exist, the map is
+// returned unchanged. O(n): the map is cloned first, to avoid mutating any
+// other OrderedMap value derived from om.
+func (om OrderedMap[K, V]) MoveToFront(key K) OrderedMap[K, V] {
+	if _, exists := om.entries[key]; !exists {
+		return om
+	}
+
+	fresh := om.clone()
+	e := fresh.entries[key]
+
+	fresh.unlink(e)
+	fresh.linkFront(e)
+
+	return fresh
+}
+
+// MoveToBack moves key to the back of the map, preserving the relative
+// order of the remaining entries. If the key does not exist, the map is
+// returned unchanged. O(n): the map is cloned first, to avoid mutating any
+// other OrderedMap value derived from om.
+func (om OrderedMap[K, V]) MoveToBack(key K) OrderedMap[K, V] {
+	if _, exists := om.entries[key]; !exists {
+		return om
+	}
+
+	fresh := om.clone()
+	e := fresh.entries[key]
+
+	fresh.unlink(e)
+	fresh.linkBack(e)
+
+	return fresh
+}
+
+// MoveBefore moves key so that it immediately precedes mark, preserving
+// the relative order of the remaining entries. If key or mark does not
+// exist, the map is returned unchanged. O(n): the map is cloned first, to
+// avoid mutating any other OrderedMap value derived from om.
+func (om OrderedMap[K, V]) MoveBefore(key, mark K) OrderedMap[K, V] {
+	if _, exists := om.entries[key]; !exists {
+		return om
+	}
+	if _, exists := om.entries[mark]; !exists || key == mark {
+		return om
+	}
+
+	fresh := om.clone()
+	e := fresh.entries[key]
+	markNode := fresh.entries[mark]
+
+	fresh.unlink(e)
+	fresh.linkBefore(e, markNode)
+
+	return fresh
+}
+
+// MoveAfter moves key so that it immediately follows mark, preserving the
+// relative order of the remaining entries. If key or mark does not exist,
+// the map is returned unchanged. O(n): the map is cloned first, to avoid
+// mutating any other OrderedMap value derived from om.
+func (om OrderedMap[K, V]) MoveAfter(key, mark K) OrderedMap[K, V] {
+	if _, exists := om.entries[key]; !exists {
+		return om
+	}
+	if _, exists := om.entries[mark]; !exists || key == mark {
+		return om
+	}
+
+	fresh := om.clone()
+	e := fresh.entries[key]
+	markNode := fresh.entries[mark]
+
+	fresh.unlink(e)
+	fresh.linkAfter(e, markNode)
+
+	return fresh
 }
 
 // Len returns the number of elements in the map.
 func (om OrderedMap[K, V]) Len() int {
-	return len(om.list)
+	return om.length
 }
 
 // Contains returns true if the key exists in the map.
 func (om OrderedMap[K, V]) Contains(key K) bool {
-	_, exists := om.keyPosition[key]
+	_, exists := om.entries[key]
 	return exists
 }
 
 // Get returns the value associated with the key.
 // If the key does not exist, the zero value of the value type is returned.
 func (om OrderedMap[K, V]) Get(key K) V {
-	ix, exists := om.keyPosition[key]
+	e, exists := om.entries[key]
 	if !exists {
 		var result V
 		return result
 	}
 
-	return om.list[ix]
+	return e.value
 }
 
 // ForEach iterates over the map, calling the function for each key-value pair.
 // If the function returns an error, the iteration stops and the error is returned.
 func (om *OrderedMap[K, V]) ForEach(fn func(key K, val V) error) error {
-	for ix, asserts := range om.list {
-		K := om.positionKey[ix]
-		err := fn(K, asserts)
-		if err != nil {
+	for e := om.head; e != nil; e = e.next {
+		if err := fn(e.key, e.value); err != nil {
 			return err
 		}
 	}
@@ -237,6 +598,10 @@ func (om OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(j)
 }
 
+// UnmarshalJSON decodes the map from its [{"Key":..,"Value":..}, ...] JSON
+// form, in order. Nested OrderedMap values decode without preparation:
+// each entry starts from a zero-valued OrderedMap, and Set lazily
+// initializes its internal maps on first use.
 func (om *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
 	aux := []jsonOrderedMapEntry[K, V]{}
 	if err := json.Unmarshal(data, &aux); err != nil {
@@ -0,0 +1,34 @@
+package orderedmap_test
+
+import (
+	"testing"
+
+	"github.com/schoren/orderedmap"
+)
+
+// benchmarkDelete exercises a delete-heavy workload: each iteration
+// deletes an entry and re-inserts it, so the map size stays constant
+// while repeatedly touching the doubly-linked-list backing store. Both
+// Delete and Set clone the map before mutating, so that the result stays
+// safe to use even if another OrderedMap value aliasing om is still held
+// elsewhere; per-op time therefore scales with n rather than staying
+// flat.
+func benchmarkDelete(b *testing.B, n int) {
+	b.Helper()
+
+	pairs := make([]orderedmap.Pair[int, int], n)
+	for i := 0; i < n; i++ {
+		pairs[i] = orderedmap.Pair[int, int]{Key: i, Value: i}
+	}
+	om := orderedmap.New(orderedmap.WithCapacity[int, int](n), orderedmap.WithInitialData(pairs...))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		key := i % n
+		om = om.Delete(key)
+		om, _ = om.Set(key, key)
+	}
+}
+
+func BenchmarkDelete_1e3(b *testing.B) { benchmarkDelete(b, 1_000) }
+func BenchmarkDelete_1e5(b *testing.B) { benchmarkDelete(b, 100_000) }
@@ -173,6 +173,352 @@ func TestJSON(t *testing.T) {
 	assertSampleOrder(t, om)
 }
 
+func TestNewWithCapacity(t *testing.T) {
+	t.Parallel()
+
+	om := orderedmap.New(orderedmap.WithCapacity[string, string](10))
+
+	assert.Equal(t, 0, om.Len())
+}
+
+func TestNewWithInitialData(t *testing.T) {
+	t.Parallel()
+
+	om := orderedmap.New(orderedmap.WithInitialData(
+		orderedmap.Pair[string, string]{Key: sample[0].key, Value: sample[0].value},
+		orderedmap.Pair[string, string]{Key: sample[1].key, Value: sample[1].value},
+		orderedmap.Pair[string, string]{Key: sample[2].key, Value: sample[2].value},
+	))
+
+	assert.Equal(t, len(sample), om.Len())
+	assertSampleOrder(t, om)
+}
+
+func TestNewWithCapacityAndInitialDataCombined(t *testing.T) {
+	t.Parallel()
+
+	pairs := []orderedmap.Pair[string, string]{
+		{Key: sample[0].key, Value: sample[0].value},
+		{Key: sample[1].key, Value: sample[1].value},
+		{Key: sample[2].key, Value: sample[2].value},
+	}
+
+	withCapacityLast := orderedmap.New(
+		orderedmap.WithInitialData(pairs...),
+		orderedmap.WithCapacity[string, string](10),
+	)
+	assert.Equal(t, len(sample), withCapacityLast.Len())
+	assertSampleOrder(t, withCapacityLast)
+	for _, s := range sample {
+		assert.True(t, withCapacityLast.Contains(s.key))
+		assert.Equal(t, s.value, withCapacityLast.Get(s.key))
+	}
+
+	withCapacityFirst := orderedmap.New(
+		orderedmap.WithCapacity[string, string](10),
+		orderedmap.WithInitialData(pairs...),
+	)
+	assert.Equal(t, len(sample), withCapacityFirst.Len())
+	assertSampleOrder(t, withCapacityFirst)
+	for _, s := range sample {
+		assert.True(t, withCapacityFirst.Contains(s.key))
+		assert.Equal(t, s.value, withCapacityFirst.Get(s.key))
+	}
+}
+
+func TestAddPairs(t *testing.T) {
+	t.Parallel()
+
+	om := orderedmap.New[string, string]()
+	om, err := om.AddPairs(
+		orderedmap.Pair[string, string]{Key: sample[0].key, Value: sample[0].value},
+		orderedmap.Pair[string, string]{Key: sample[1].key, Value: sample[1].value},
+		orderedmap.Pair[string, string]{Key: sample[2].key, Value: sample[2].value},
+	)
+	require.NoError(t, err)
+
+	assertSampleOrder(t, om)
+}
+
+func TestAddPairsReturnsErrorIfKeyAlreadyExists(t *testing.T) {
+	t.Parallel()
+
+	om := orderedmap.New[string, string]().MustSet("key", "value")
+
+	_, err := om.AddPairs(orderedmap.Pair[string, string]{Key: "key", Value: "other"})
+	assert.ErrorIs(t, err, orderedmap.ErrKeyAlreadyExists)
+}
+
+func TestInsertAt(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	om, err := om.InsertAt(1, "inserted", "x")
+	require.NoError(t, err)
+
+	assert.Equal(t, len(sample)+1, om.Len())
+
+	key, ok := om.KeyAt(1)
+	assert.True(t, ok)
+	assert.Equal(t, "inserted", key)
+
+	key, ok = om.KeyAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, sample[0].key, key)
+
+	key, ok = om.KeyAt(2)
+	assert.True(t, ok)
+	assert.Equal(t, sample[1].key, key)
+}
+
+func TestInsertAtNegativePosition(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	om, err := om.InsertAt(-1, "inserted", "x")
+	require.NoError(t, err)
+
+	key, ok := om.KeyAt(-2)
+	assert.True(t, ok)
+	assert.Equal(t, "inserted", key)
+}
+
+func TestInsertAtOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+
+	_, err := om.InsertAt(100, "inserted", "x")
+	assert.ErrorIs(t, err, orderedmap.ErrPositionOutOfRange)
+
+	_, err = om.InsertAt(-100, "inserted", "x")
+	assert.ErrorIs(t, err, orderedmap.ErrPositionOutOfRange)
+}
+
+func TestInsertAtReturnsErrorIfKeyAlreadyExists(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+
+	_, err := om.InsertAt(0, sample[0].key, "x")
+	assert.ErrorIs(t, err, orderedmap.ErrKeyAlreadyExists)
+}
+
+func TestGetAt(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+
+	key, val, ok := om.GetAt(1)
+	assert.True(t, ok)
+	assert.Equal(t, sample[1].key, key)
+	assert.Equal(t, sample[1].value, val)
+
+	key, val, ok = om.GetAt(-1)
+	assert.True(t, ok)
+	assert.Equal(t, sample[len(sample)-1].key, key)
+	assert.Equal(t, sample[len(sample)-1].value, val)
+
+	_, _, ok = om.GetAt(100)
+	assert.False(t, ok)
+}
+
+func TestKeyAt(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+
+	key, ok := om.KeyAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, sample[0].key, key)
+
+	_, ok = om.KeyAt(100)
+	assert.False(t, ok)
+}
+
+func TestMoveToFront(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	om = om.MoveToFront(sample[2].key)
+
+	key, ok := om.KeyAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, sample[2].key, key)
+	assert.Equal(t, len(sample), om.Len())
+}
+
+func TestMoveToFrontOnMissingKeyIsNoop(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	moved := om.MoveToFront("missing")
+
+	assertSampleOrder(t, moved)
+}
+
+func TestMoveToBack(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	om = om.MoveToBack(sample[0].key)
+
+	key, ok := om.KeyAt(-1)
+	assert.True(t, ok)
+	assert.Equal(t, sample[0].key, key)
+	assert.Equal(t, len(sample), om.Len())
+}
+
+func TestMoveBefore(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	om = om.MoveBefore(sample[2].key, sample[0].key)
+
+	key, ok := om.KeyAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, sample[2].key, key)
+}
+
+func TestMoveAfter(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+	om = om.MoveAfter(sample[0].key, sample[2].key)
+
+	key, ok := om.KeyAt(-1)
+	assert.True(t, ok)
+	assert.Equal(t, sample[0].key, key)
+}
+
+func TestSetDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := orderedmap.New[string, int]().MustSet("a", 1)
+	om2 := om1
+	om2, err := om2.Set("b", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, om1.Len())
+	assert.False(t, om1.Contains("b"))
+	assert.Equal(t, 2, om2.Len())
+}
+
+func TestAddPairsDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := orderedmap.New[string, int]().MustSet("a", 1)
+	om2 := om1
+	om2, err := om2.AddPairs(
+		orderedmap.Pair[string, int]{Key: "b", Value: 2},
+		orderedmap.Pair[string, int]{Key: "c", Value: 3},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, om1.Len())
+	assert.False(t, om1.Contains("b"))
+	assert.Equal(t, 3, om2.Len())
+}
+
+func TestDeleteDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := newOMFromSample(t)
+	om2 := om1
+	om2 = om2.Delete(sample[1].key)
+
+	assert.Equal(t, len(sample), om1.Len())
+	assert.True(t, om1.Contains(sample[1].key))
+	assertSampleOrder(t, om1)
+
+	assert.Equal(t, len(sample)-1, om2.Len())
+	assert.False(t, om2.Contains(sample[1].key))
+}
+
+func TestInsertAtDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := newOMFromSample(t)
+	om2 := om1
+	om2, err := om2.InsertAt(1, "inserted", "x")
+	require.NoError(t, err)
+
+	assert.Equal(t, len(sample), om1.Len())
+	assert.False(t, om1.Contains("inserted"))
+	assertSampleOrder(t, om1)
+
+	assert.Equal(t, len(sample)+1, om2.Len())
+	assert.True(t, om2.Contains("inserted"))
+}
+
+func TestMoveToFrontDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := newOMFromSample(t)
+	om2 := om1
+	om2 = om2.MoveToFront(sample[2].key)
+
+	assertSampleOrder(t, om1)
+
+	key, ok := om2.KeyAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, sample[2].key, key)
+}
+
+func TestMoveToBackDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := newOMFromSample(t)
+	om2 := om1
+	om2 = om2.MoveToBack(sample[0].key)
+
+	assertSampleOrder(t, om1)
+
+	key, ok := om2.KeyAt(-1)
+	assert.True(t, ok)
+	assert.Equal(t, sample[0].key, key)
+}
+
+func TestMoveBeforeDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := newOMFromSample(t)
+	om2 := om1
+	om2 = om2.MoveBefore(sample[2].key, sample[0].key)
+
+	assertSampleOrder(t, om1)
+
+	key, ok := om2.KeyAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, sample[2].key, key)
+}
+
+func TestMoveAfterDoesNotMutateAliasedCopy(t *testing.T) {
+	t.Parallel()
+
+	om1 := newOMFromSample(t)
+	om2 := om1
+	om2 = om2.MoveAfter(sample[0].key, sample[2].key)
+
+	assertSampleOrder(t, om1)
+
+	key, ok := om2.KeyAt(-1)
+	assert.True(t, ok)
+	assert.Equal(t, sample[0].key, key)
+}
+
+func TestUnmarshalJSONNestedOrderedMap(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`[{"Key":"outer","Value":[{"Key":"inner","Value":1}]}]`)
+
+	var om orderedmap.OrderedMap[string, orderedmap.OrderedMap[string, int]]
+	err := om.UnmarshalJSON(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, om.Get("outer").Get("inner"))
+}
+
 func TestNonUniqueJson(t *testing.T) {
 	t.Parallel()
 
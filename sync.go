@@ -0,0 +1,107 @@
+package orderedmap
+
+import "sync"
+
+// SyncOrderedMap is a concurrency-safe wrapper around OrderedMap, guarding
+// every operation with a sync.RWMutex. OrderedMap's value-receiver design
+// means concurrent Set calls on copies of the same map silently lose
+// writes; SyncOrderedMap instead mutates a single shared instance under
+// lock. The zero value is ready to use.
+type SyncOrderedMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	om OrderedMap[K, V]
+}
+
+// NewSync creates a new SyncOrderedMap.
+func NewSync[K comparable, V any](opts ...Option[K, V]) *SyncOrderedMap[K, V] {
+	return &SyncOrderedMap[K, V]{om: New(opts...)}
+}
+
+// Set adds a new key-value pair to the map.
+// If the key already exists, an error is returned.
+func (s *SyncOrderedMap[K, V]) Set(key K, value V) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	om, err := s.om.Set(key, value)
+	if err != nil {
+		return err
+	}
+	s.om = om
+
+	return nil
+}
+
+// MustSet is like Set, but panics if an error occurs.
+func (s *SyncOrderedMap[K, V]) MustSet(key K, value V) {
+	if err := s.Set(key, value); err != nil {
+		panic(err)
+	}
+}
+
+// Delete removes a key from the map.
+// If the key does not exist, the map is unchanged.
+func (s *SyncOrderedMap[K, V]) Delete(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.om = s.om.Delete(key)
+}
+
+// Get returns the value associated with the key.
+// If the key does not exist, the zero value of the value type is returned.
+func (s *SyncOrderedMap[K, V]) Get(key K) V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.om.Get(key)
+}
+
+// Contains returns true if the key exists in the map.
+func (s *SyncOrderedMap[K, V]) Contains(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.om.Contains(key)
+}
+
+// Len returns the number of elements in the map.
+func (s *SyncOrderedMap[K, V]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.om.Len()
+}
+
+// ForEach iterates over a snapshot of the map's keys and values, copied
+// under a read lock before fn is called, so fn can safely call back into
+// the map without deadlocking. If fn returns an error, the iteration
+// stops and the error is returned.
+func (s *SyncOrderedMap[K, V]) ForEach(fn func(key K, val V) error) error {
+	s.mu.RLock()
+	keys := make([]K, 0, s.om.Len())
+	values := make([]V, 0, s.om.Len())
+	_ = s.om.ForEach(func(key K, val V) error {
+		keys = append(keys, key)
+		values = append(values, val)
+		return nil
+	})
+	s.mu.RUnlock()
+
+	for i, key := range keys {
+		if err := fn(key, values[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Unordered returns a map with the same key-value pairs, but in an
+// unordered map.
+func (s *SyncOrderedMap[K, V]) Unordered() map[K]V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.om.Unordered()
+}
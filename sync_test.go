@@ -0,0 +1,66 @@
+package orderedmap_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/schoren/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncOrderedMapConcurrentSet(t *testing.T) {
+	t.Parallel()
+
+	som := orderedmap.NewSync[int, int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, som.Set(i, i))
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, som.Len())
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, i, som.Get(i))
+	}
+}
+
+func TestSyncOrderedMapForEachSnapshot(t *testing.T) {
+	t.Parallel()
+
+	som := orderedmap.NewSync[string, string]()
+	for _, s := range sample {
+		som.MustSet(s.key, s.value)
+	}
+
+	i := 0
+	err := som.ForEach(func(key, val string) error {
+		assert.Equal(t, sample[i].key, key)
+		assert.Equal(t, sample[i].value, val)
+
+		som.Set("extra-"+strconv.Itoa(i), val)
+
+		i++
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, len(sample)+i, som.Len())
+}
+
+func TestSyncOrderedMapDelete(t *testing.T) {
+	t.Parallel()
+
+	som := orderedmap.NewSync[string, string]()
+	som.MustSet("key", "value")
+	som.Delete("key")
+
+	assert.False(t, som.Contains("key"))
+	assert.Equal(t, 0, som.Len())
+}
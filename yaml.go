@@ -0,0 +1,68 @@
+package orderedmap
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML encodes the map as a YAML mapping node, preserving
+// insertion order.
+func (om OrderedMap[K, V]) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+
+	var outerErr error
+	_ = om.ForEach(func(key K, val V) error {
+		keyNode := &yaml.Node{}
+		if err := keyNode.Encode(key); err != nil {
+			outerErr = err
+			return err
+		}
+
+		valNode := &yaml.Node{}
+		if err := valNode.Encode(val); err != nil {
+			outerErr = err
+			return err
+		}
+
+		node.Content = append(node.Content, keyNode, valNode)
+		return nil
+	})
+	if outerErr != nil {
+		return nil, outerErr
+	}
+
+	return node, nil
+}
+
+// UnmarshalYAML decodes a YAML mapping node into the map, walking its
+// Content pairs in order so insertion order matches document order.
+// If the node contains a duplicated key, ErrKeyAlreadyExists is returned.
+func (om *OrderedMap[K, V]) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("orderedmap: cannot unmarshal YAML node of kind %d into OrderedMap", value.Kind)
+	}
+
+	newMap := OrderedMap[K, V]{}
+	for i := 0; i < len(value.Content); i += 2 {
+		var key K
+		if err := value.Content[i].Decode(&key); err != nil {
+			return err
+		}
+
+		var val V
+		if err := value.Content[i+1].Decode(&val); err != nil {
+			return err
+		}
+
+		var err error
+		newMap, err = newMap.Set(key, val)
+		if err != nil {
+			return err
+		}
+	}
+
+	om.replace(&newMap)
+
+	return nil
+}
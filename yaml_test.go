@@ -0,0 +1,35 @@
+package orderedmap_test
+
+import (
+	"testing"
+
+	"github.com/schoren/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAML(t *testing.T) {
+	t.Parallel()
+
+	om := newOMFromSample(t)
+
+	data, err := yaml.Marshal(om)
+	require.NoError(t, err)
+
+	var unmarshalled orderedmap.OrderedMap[string, string]
+	err = yaml.Unmarshal(data, &unmarshalled)
+	require.NoError(t, err)
+
+	assertSampleOrder(t, unmarshalled)
+}
+
+func TestYAMLNonUnique(t *testing.T) {
+	t.Parallel()
+
+	nonUniqueYAML := "1: a\n1: b\n"
+
+	var om orderedmap.OrderedMap[string, string]
+	err := yaml.Unmarshal([]byte(nonUniqueYAML), &om)
+	assert.ErrorIs(t, err, orderedmap.ErrKeyAlreadyExists)
+}